@@ -1,27 +1,82 @@
 package ncaafb
 
 import (
+	"context"
+	"encoding/json"
 	"encoding/xml"
-	"errors"
 	"fmt"
-	"io/ioutil"
+	"io"
 	"log"
+	"math/rand"
 	"net/http"
 	"net/url"
+	"strconv"
 	"time"
+
+	"golang.org/x/time/rate"
 )
 
+// defaultMaxRetries bounds how many times a request is retried on a
+// retryable (429/5xx) response before giving up.
+const defaultMaxRetries = 3
+
 type API struct {
 	apiKey     string
 	production bool
 	log        bool
+
+	client     *http.Client
+	limiter    *rate.Limiter
+	maxRetries int
+	cache      Cache
+	format     Format
+}
+
+// Options configures an API client. The zero value of every field picks the
+// default that NewAPI uses: http.DefaultClient, one request per second,
+// defaultMaxRetries retries, no cache, and XML responses. A zero MaxRetries
+// therefore means the default, not zero retries.
+type Options struct {
+	HTTPClient  *http.Client
+	RateLimiter *rate.Limiter
+	MaxRetries  int
+	// Cache, when set, is consulted before each request and populated
+	// after a successful one. Endpoints pick their own CachePolicy; see
+	// Division, Schedule, and Boxscore.
+	Cache Cache
+	// Format selects whether endpoints are requested (and decoded) as
+	// XML or JSON. Defaults to FormatXML.
+	Format Format
 }
 
 func NewAPI(apiKey string, production, log bool) *API {
+	return NewAPIWithOptions(apiKey, production, log, Options{})
+}
+
+// NewAPIWithOptions is like NewAPI but lets callers supply their own HTTP
+// client, rate limiter, and retry budget instead of the defaults.
+func NewAPIWithOptions(apiKey string, production, log bool, opts Options) *API {
+	if opts.HTTPClient == nil {
+		opts.HTTPClient = http.DefaultClient
+	}
+	if opts.RateLimiter == nil {
+		opts.RateLimiter = rate.NewLimiter(rate.Every(time.Second), 1)
+	}
+	if opts.MaxRetries == 0 {
+		opts.MaxRetries = defaultMaxRetries
+	}
+	if opts.Format == "" {
+		opts.Format = FormatXML
+	}
 	return &API{
 		apiKey:     apiKey,
 		production: production,
 		log:        log,
+		client:     opts.HTTPClient,
+		limiter:    opts.RateLimiter,
+		maxRetries: opts.MaxRetries,
+		cache:      opts.Cache,
+		format:     opts.Format,
 	}
 }
 
@@ -64,6 +119,22 @@ var DivisionAll = []DivisionType{
 	DivisionUSCAA,
 }
 
+// Format selects the wire format requested from the Sportradar endpoints
+// and, correspondingly, how the response body is decoded.
+type Format string
+
+const (
+	FormatXML  = Format("xml")
+	FormatJSON = Format("json")
+)
+
+func (a *API) decode(body []byte, v interface{}) error {
+	if a.format == FormatJSON {
+		return json.Unmarshal(body, v)
+	}
+	return xml.Unmarshal(body, v)
+}
+
 func (a *API) baseEndpoint() string {
 	var accessLevel AccessLevelType
 	if a.production {
@@ -79,7 +150,7 @@ func (a *API) baseEndpoint() string {
 }
 
 func (a *API) divisionEndpoint(divisionType DivisionType) (*url.URL, error) {
-	endpoint := fmt.Sprintf("%s/teams/%s/hierarchy.xml", a.baseEndpoint(), string(divisionType))
+	endpoint := fmt.Sprintf("%s/teams/%s/hierarchy.%s", a.baseEndpoint(), string(divisionType), string(a.format))
 	u, err := url.Parse(endpoint)
 	if err != nil {
 		return nil, err
@@ -94,7 +165,7 @@ func (a *API) divisionEndpoint(divisionType DivisionType) (*url.URL, error) {
 }
 
 func (a *API) scheduleEndpoint(year string, scheduleType ScheduleType) (*url.URL, error) {
-	endpoint := fmt.Sprintf("%s/%s/%s/schedule.xml", a.baseEndpoint(), year, string(scheduleType))
+	endpoint := fmt.Sprintf("%s/%s/%s/schedule.%s", a.baseEndpoint(), year, string(scheduleType), string(a.format))
 	u, err := url.Parse(endpoint)
 	if err != nil {
 		return nil, err
@@ -110,7 +181,7 @@ func (a *API) scheduleEndpoint(year string, scheduleType ScheduleType) (*url.URL
 
 func (a *API) boxscoreEndpoint(year string, scheduleType ScheduleType, week, awayTeamId, homeTeamId string) (*url.URL, error) {
 	//http(s)://api.sportsdatallc.org/ncaafb-[access_level][version]/[year]/[ncaafb_season]/[ncaafb_season_week]/[away_team]/[home_team]/boxscore.[format]?api_key=[your_api_key]
-	endpoint := fmt.Sprintf("%s/%s/%s/%s/%s/%s/boxscore.xml", a.baseEndpoint(), year, scheduleType, week, awayTeamId, homeTeamId)
+	endpoint := fmt.Sprintf("%s/%s/%s/%s/%s/%s/boxscore.%s", a.baseEndpoint(), year, scheduleType, week, awayTeamId, homeTeamId, string(a.format))
 	u, err := url.Parse(endpoint)
 	if err != nil {
 		return nil, err
@@ -124,62 +195,170 @@ func (a *API) boxscoreEndpoint(year string, scheduleType ScheduleType, week, awa
 	return u, nil
 }
 
-func (a *API) Division(divisionType DivisionType) (*Division, error) {
-	u, err := a.divisionEndpoint(divisionType)
+// doRequest executes a GET against u, cooperating with ctx cancellation and
+// the client's rate limiter, and retrying 429/5xx responses with exponential
+// backoff and jitter (honoring a Retry-After header when present).
+//
+// If a cache is configured, policy controls how it's used: CacheDisabled
+// bypasses it, CacheImmutableAfterFinal serves a hit without revalidating,
+// and CacheRevalidate sends the stored ETag/Last-Modified as conditional
+// headers and serves the cached body on a 304 response.
+func (a *API) doRequest(ctx context.Context, u *url.URL, policy CachePolicy) ([]byte, error) {
+	key := u.String()
+	var cachedBody []byte
+	var meta CacheMeta
+	haveCached := false
+	if a.cache != nil && policy != CacheDisabled {
+		cachedBody, meta, haveCached = a.cache.Get(key)
+		if haveCached && policy == CacheImmutableAfterFinal {
+			return cachedBody, nil
+		}
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= a.maxRetries; attempt++ {
+		if err := a.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, key, nil)
+		if err != nil {
+			return nil, err
+		}
+		if haveCached {
+			if meta.ETag != "" {
+				req.Header.Set("If-None-Match", meta.ETag)
+			}
+			if meta.LastModified != "" {
+				req.Header.Set("If-Modified-Since", meta.LastModified)
+			}
+		}
+		resp, err := a.client.Do(req)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			lastErr = err
+			continue
+		}
+		if haveCached && resp.StatusCode == http.StatusNotModified {
+			resp.Body.Close()
+			return cachedBody, nil
+		}
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if resp.StatusCode == http.StatusOK {
+			if a.cache != nil && policy != CacheDisabled {
+				a.cache.Put(key, body, cacheMetaFromResponse(resp))
+			}
+			return body, nil
+		}
+		wait := retryBackoff(attempt, resp.Header.Get("Retry-After"))
+		apiErr := &APIError{
+			StatusCode: resp.StatusCode,
+			URL:        key,
+			Endpoint:   u.Path,
+			RetryAfter: wait,
+			Body:       body,
+		}
+		lastErr = apiErr
+		if !isRetryableStatus(resp.StatusCode) || attempt == a.maxRetries {
+			return nil, lastErr
+		}
+		if a.log {
+			log.Printf("retrying %s after %s (status %d, attempt %d)\n", key, wait, resp.StatusCode, attempt+1)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+	return nil, lastErr
+}
+
+func isRetryableStatus(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError
+}
+
+// retryBackoff honors a Retry-After header (seconds or HTTP-date) when
+// present, otherwise falls back to exponential backoff with jitter.
+func retryBackoff(attempt int, retryAfter string) time.Duration {
+	if retryAfter != "" {
+		if secs, err := strconv.Atoi(retryAfter); err == nil {
+			return time.Duration(secs) * time.Second
+		}
+		if when, err := http.ParseTime(retryAfter); err == nil {
+			if d := time.Until(when); d > 0 {
+				return d
+			}
+		}
+	}
+	base := time.Duration(1<<uint(attempt)) * 500 * time.Millisecond
+	jitter := time.Duration(rand.Int63n(int64(base/2) + 1))
+	return base + jitter
+}
+
+// Raw fetches endpoint (a path relative to the versioned base URL, e.g.
+// "teams/FBS/hierarchy.xml") and returns the undecoded response body, for
+// callers who want to decode into their own types ahead of fields this
+// package hasn't added yet.
+func (a *API) Raw(ctx context.Context, endpoint string) ([]byte, error) {
+	u, err := url.Parse(fmt.Sprintf("%s/%s", a.baseEndpoint(), endpoint))
 	if err != nil {
 		return nil, err
 	}
-	endpoint := u.String()
-	time.Sleep(1 * time.Second)
-	resp, err := http.Get(endpoint)
+	q := u.Query()
+	q.Set("api_key", a.apiKey)
+	u.RawQuery = q.Encode()
+	return a.doRequest(ctx, u, CacheDisabled)
+}
+
+func (a *API) Division(ctx context.Context, divisionType DivisionType) (*Division, error) {
+	u, err := a.divisionEndpoint(divisionType)
 	if err != nil {
 		return nil, err
 	}
-	if resp.StatusCode != http.StatusOK {
-		return nil, errors.New(fmt.Sprintf("API Status Returned Code %d.\nRequest: %+v\nResponse: %+v\n", resp.StatusCode, resp.Request, resp))
-	}
-	defer resp.Body.Close()
-	body, err := ioutil.ReadAll(resp.Body)
+	body, err := a.doRequest(ctx, u, CacheImmutableAfterFinal)
 	if err != nil {
 		return nil, err
 	}
 	division := new(Division)
-	err = xml.Unmarshal(body, division)
+	err = a.decode(body, division)
 	return division, err
 }
 
-func (a *API) AllDivisions() ([]*Division, error) {
-	divisions := make([]*Division, 0)
+// AllDivisions fetches every DivisionType in order, stopping and returning
+// whatever it has gathered so far if ctx is cancelled or a fetch fails.
+func (a *API) AllDivisions(ctx context.Context) ([]*Division, error) {
+	divisions := make([]*Division, 0, len(DivisionAll))
 	for _, divisionType := range DivisionAll {
-		division, err := a.Division(divisionType)
+		if err := ctx.Err(); err != nil {
+			return divisions, err
+		}
+		division, err := a.Division(ctx, divisionType)
 		if err != nil {
-			return nil, err
+			return divisions, err
 		}
 		divisions = append(divisions, division)
 	}
 	return divisions, nil
 }
 
-func (a *API) Schedule(year string, scheduleType ScheduleType) (*Schedule, error) {
+func (a *API) Schedule(ctx context.Context, year string, scheduleType ScheduleType) (*Schedule, error) {
 	u, err := a.scheduleEndpoint(year, scheduleType)
 	if err != nil {
 		return nil, err
 	}
-	time.Sleep(1 * time.Second)
-	resp, err := http.Get(u.String())
-	if err != nil {
-		return nil, err
-	}
-	if resp.StatusCode != http.StatusOK {
-		return nil, errors.New(fmt.Sprintf("API Status Returned Code %d.\nRequest: %+v\nResponse: %+v\n", resp.StatusCode, resp.Request, resp))
-	}
-	defer resp.Body.Close()
-	body, err := ioutil.ReadAll(resp.Body)
+	body, err := a.doRequest(ctx, u, CacheRevalidate)
 	if err != nil {
 		return nil, err
 	}
 	season := new(Season)
-	err = xml.Unmarshal(body, season)
+	err = a.decode(body, season)
 	if err != nil {
 		return nil, err
 	}
@@ -190,13 +369,19 @@ func (a *API) Schedule(year string, scheduleType ScheduleType) (*Schedule, error
 	return schedule, nil
 }
 
-func (a *API) AllSchedules(years []string) ([]*Schedule, error) {
-	schedules := make([]*Schedule, 0)
+// AllSchedules fetches every (year, scheduleType) pair in order, stopping
+// and returning whatever it has gathered so far if ctx is cancelled or a
+// fetch fails.
+func (a *API) AllSchedules(ctx context.Context, years []string) ([]*Schedule, error) {
+	schedules := make([]*Schedule, 0, len(years)*len(ScheduleAll))
 	for _, year := range years {
 		for _, scheduleType := range ScheduleAll {
-			schedule, err := a.Schedule(year, scheduleType)
+			if err := ctx.Err(); err != nil {
+				return schedules, err
+			}
+			schedule, err := a.Schedule(ctx, year, scheduleType)
 			if err != nil {
-				return nil, err
+				return schedules, err
 			}
 			schedules = append(schedules, schedule)
 		}
@@ -204,26 +389,17 @@ func (a *API) AllSchedules(years []string) ([]*Schedule, error) {
 	return schedules, nil
 }
 
-func (a *API) Boxscore(year string, scheduleType ScheduleType, week, awayTeamId, homeTeamId string) (*Boxscore, error) {
+func (a *API) Boxscore(ctx context.Context, year string, scheduleType ScheduleType, week, awayTeamId, homeTeamId string) (*Boxscore, error) {
 	u, err := a.boxscoreEndpoint(year, scheduleType, week, awayTeamId, homeTeamId)
 	if err != nil {
 		return nil, err
 	}
-	time.Sleep(1 * time.Second)
-	resp, err := http.Get(u.String())
-	if err != nil {
-		return nil, err
-	}
-	if resp.StatusCode != http.StatusOK {
-		return nil, errors.New(fmt.Sprintf("API Status Returned Code %d.\nRequest: %+v\nResponse: %+v\n", resp.StatusCode, resp.Request, resp))
-	}
-	defer resp.Body.Close()
-	body, err := ioutil.ReadAll(resp.Body)
+	body, err := a.doRequest(ctx, u, CacheRevalidate)
 	if err != nil {
 		return nil, err
 	}
 	boxscore := new(Boxscore)
-	err = xml.Unmarshal(body, boxscore)
+	err = a.decode(body, boxscore)
 	if err != nil {
 		return nil, err
 	}
@@ -233,24 +409,45 @@ func (a *API) Boxscore(year string, scheduleType ScheduleType, week, awayTeamId,
 	return boxscore, nil
 }
 
-func (a *API) ScheduleBoxscores(schedule *Schedule, ids []string) ([]*Boxscore, error) {
-	boxscores := make([]*Boxscore, 0)
+// gamesByIDs flattens a schedule's weeks into the games matching ids, in
+// schedule order, pairing each with the week it was played in.
+func gamesByIDs(schedule *Schedule, ids []string) []gameRef {
+	refs := make([]gameRef, 0, len(ids))
 	for _, w := range schedule.Season.Weeks {
 		for _, g := range w.Games {
 			for _, id := range ids {
 				if g.Id == id {
-					if a.log {
-						log.Printf("Getting boxscore for %s: %s, %s, %s, %s, %s\n", g.Id, schedule.Year, schedule.ScheduleType, w.Week, g.AwayTeamId, g.HomeTeamId)
-					}
-					boxscore, err := a.Boxscore(schedule.Year, schedule.ScheduleType, w.Week, g.AwayTeamId, g.HomeTeamId)
-					if err != nil {
-						return nil, err
-					}
-					boxscores = append(boxscores, boxscore)
+					refs = append(refs, gameRef{week: w.Week, game: g})
 					break
 				}
 			}
 		}
 	}
+	return refs
+}
+
+type gameRef struct {
+	week string
+	game Game
+}
+
+// ScheduleBoxscores fetches the boxscore for each game id found in schedule,
+// in schedule order, stopping and returning whatever it has gathered so far
+// if ctx is cancelled or a fetch fails.
+func (a *API) ScheduleBoxscores(ctx context.Context, schedule *Schedule, ids []string) ([]*Boxscore, error) {
+	boxscores := make([]*Boxscore, 0, len(ids))
+	for _, ref := range gamesByIDs(schedule, ids) {
+		if err := ctx.Err(); err != nil {
+			return boxscores, err
+		}
+		if a.log {
+			log.Printf("Getting boxscore for %s: %s, %s, %s, %s, %s\n", ref.game.Id, schedule.Year, schedule.ScheduleType, ref.week, ref.game.AwayTeamId, ref.game.HomeTeamId)
+		}
+		boxscore, err := a.Boxscore(ctx, schedule.Year, schedule.ScheduleType, ref.week, ref.game.AwayTeamId, ref.game.HomeTeamId)
+		if err != nil {
+			return boxscores, err
+		}
+		boxscores = append(boxscores, boxscore)
+	}
 	return boxscores, nil
 }
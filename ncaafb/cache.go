@@ -0,0 +1,153 @@
+package ncaafb
+
+import (
+	"container/list"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// CacheMeta carries the revalidation headers stored alongside a cached
+// response body.
+type CacheMeta struct {
+	ETag         string
+	LastModified string
+}
+
+// Cache is the pluggable storage backend for cached endpoint responses. Get
+// reports whether key was found; Put stores (or overwrites) an entry.
+type Cache interface {
+	Get(key string) (body []byte, meta CacheMeta, ok bool)
+	Put(key string, body []byte, meta CacheMeta)
+}
+
+// CachePolicy controls how a cached entry is used once present.
+type CachePolicy int
+
+const (
+	// CacheDisabled never reads or writes the cache.
+	CacheDisabled CachePolicy = iota
+	// CacheRevalidate serves a cache hit only after a conditional request
+	// confirms (via 304) that it's still current.
+	CacheRevalidate
+	// CacheImmutableAfterFinal serves a cache hit as-is, skipping
+	// revalidation entirely. Intended for endpoints that can't change
+	// once written, e.g. a completed game's boxscore.
+	CacheImmutableAfterFinal
+)
+
+// LRUCache is an in-memory Cache bounded to capacity entries, evicting the
+// least recently used entry once full.
+type LRUCache struct {
+	mu       sync.Mutex
+	capacity int
+	ll       *list.List
+	items    map[string]*list.Element
+}
+
+type lruEntry struct {
+	key  string
+	body []byte
+	meta CacheMeta
+}
+
+func NewLRUCache(capacity int) *LRUCache {
+	if capacity <= 0 {
+		capacity = 1
+	}
+	return &LRUCache{
+		capacity: capacity,
+		ll:       list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+func (c *LRUCache) Get(key string) ([]byte, CacheMeta, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.items[key]
+	if !ok {
+		return nil, CacheMeta{}, false
+	}
+	c.ll.MoveToFront(el)
+	entry := el.Value.(*lruEntry)
+	return entry.body, entry.meta, true
+}
+
+func (c *LRUCache) Put(key string, body []byte, meta CacheMeta) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.items[key]; ok {
+		c.ll.MoveToFront(el)
+		el.Value.(*lruEntry).body = body
+		el.Value.(*lruEntry).meta = meta
+		return
+	}
+	el := c.ll.PushFront(&lruEntry{key: key, body: body, meta: meta})
+	c.items[key] = el
+	if c.ll.Len() > c.capacity {
+		oldest := c.ll.Back()
+		if oldest != nil {
+			c.ll.Remove(oldest)
+			delete(c.items, oldest.Value.(*lruEntry).key)
+		}
+	}
+}
+
+// FileCache is a filesystem-backed Cache that stores each entry as a body
+// file plus a JSON metadata sidecar under dir, named by the SHA-1 of the key
+// (the full endpoint URL).
+type FileCache struct {
+	dir string
+}
+
+func NewFileCache(dir string) *FileCache {
+	return &FileCache{dir: dir}
+}
+
+func (c *FileCache) paths(key string) (bodyPath, metaPath string) {
+	sum := sha1.Sum([]byte(key))
+	name := hex.EncodeToString(sum[:])
+	return filepath.Join(c.dir, name+".body"), filepath.Join(c.dir, name+".meta.json")
+}
+
+func (c *FileCache) Get(key string) ([]byte, CacheMeta, bool) {
+	bodyPath, metaPath := c.paths(key)
+	body, err := os.ReadFile(bodyPath)
+	if err != nil {
+		return nil, CacheMeta{}, false
+	}
+	metaBytes, err := os.ReadFile(metaPath)
+	if err != nil {
+		return nil, CacheMeta{}, false
+	}
+	var meta CacheMeta
+	if err := json.Unmarshal(metaBytes, &meta); err != nil {
+		return nil, CacheMeta{}, false
+	}
+	return body, meta, true
+}
+
+func (c *FileCache) Put(key string, body []byte, meta CacheMeta) {
+	if err := os.MkdirAll(c.dir, 0o755); err != nil {
+		return
+	}
+	bodyPath, metaPath := c.paths(key)
+	metaBytes, err := json.Marshal(meta)
+	if err != nil {
+		return
+	}
+	_ = os.WriteFile(bodyPath, body, 0o644)
+	_ = os.WriteFile(metaPath, metaBytes, 0o644)
+}
+
+func cacheMetaFromResponse(resp *http.Response) CacheMeta {
+	return CacheMeta{
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+	}
+}
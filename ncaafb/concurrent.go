@@ -0,0 +1,59 @@
+package ncaafb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// BoxscoreResult pairs a requested game id with the outcome of fetching its
+// boxscore, so a partial failure doesn't discard the successes around it.
+type BoxscoreResult struct {
+	GameId   string
+	Boxscore *Boxscore
+	Err      error
+}
+
+// ScheduleBoxscoresConcurrent is like ScheduleBoxscores but fans requests
+// out across concurrency worker goroutines instead of fetching serially.
+// The rate limiter shared with the rest of the client still bounds total
+// QPS, so raising concurrency hides per-request latency rather than
+// exceeding the cap. Results preserve the order games appear in schedule;
+// per-game errors are reported in the corresponding BoxscoreResult rather
+// than aborting the whole batch.
+func (a *API) ScheduleBoxscoresConcurrent(ctx context.Context, schedule *Schedule, ids []string, concurrency int) ([]BoxscoreResult, error) {
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	refs := gamesByIDs(schedule, ids)
+	results := make([]BoxscoreResult, len(refs))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, ref := range refs {
+		i, ref := i, ref
+		wg.Add(1)
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			wg.Done()
+			results[i] = BoxscoreResult{GameId: ref.game.Id, Err: ctx.Err()}
+			continue
+		}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			boxscore, err := a.Boxscore(ctx, schedule.Year, schedule.ScheduleType, ref.week, ref.game.AwayTeamId, ref.game.HomeTeamId)
+			results[i] = BoxscoreResult{GameId: ref.game.Id, Boxscore: boxscore, Err: err}
+		}()
+	}
+	wg.Wait()
+
+	var errs []error
+	for _, r := range results {
+		if r.Err != nil {
+			errs = append(errs, fmt.Errorf("game %s: %w", r.GameId, r.Err))
+		}
+	}
+	return results, errors.Join(errs...)
+}
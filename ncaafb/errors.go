@@ -0,0 +1,50 @@
+package ncaafb
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Sentinel errors that APIError.Is matches against, so callers can branch on
+// the kind of failure without inspecting StatusCode themselves:
+//
+//	if errors.Is(err, ncaafb.ErrRateLimited) { ... }
+var (
+	ErrRateLimited  = errors.New("ncaafb: rate limited")
+	ErrUnauthorized = errors.New("ncaafb: unauthorized")
+	ErrNotFound     = errors.New("ncaafb: not found")
+	ErrServerError  = errors.New("ncaafb: server error")
+)
+
+// APIError is returned when an endpoint responds with a non-200 status
+// after retries are exhausted (or the status isn't retryable at all).
+type APIError struct {
+	StatusCode int
+	URL        string
+	Endpoint   string
+	RetryAfter time.Duration
+	Body       []byte
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("ncaafb: %s returned status %d: %s", e.URL, e.StatusCode, e.Body)
+}
+
+// Is lets callers use errors.Is(err, ncaafb.ErrRateLimited) and friends
+// instead of comparing StatusCode directly.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrServerError:
+		return e.StatusCode >= http.StatusInternalServerError
+	default:
+		return false
+	}
+}
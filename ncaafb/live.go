@@ -0,0 +1,214 @@
+package ncaafb
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+func (a *API) playByPlayEndpoint(year string, scheduleType ScheduleType, week, awayTeamId, homeTeamId string) (*url.URL, error) {
+	endpoint := fmt.Sprintf("%s/%s/%s/%s/%s/%s/pbp.%s", a.baseEndpoint(), year, scheduleType, week, awayTeamId, homeTeamId, string(a.format))
+	return a.endpointURL(endpoint)
+}
+
+func (a *API) gameStatisticsEndpoint(year string, scheduleType ScheduleType, week, awayTeamId, homeTeamId string) (*url.URL, error) {
+	endpoint := fmt.Sprintf("%s/%s/%s/%s/%s/%s/statistics.%s", a.baseEndpoint(), year, scheduleType, week, awayTeamId, homeTeamId, string(a.format))
+	return a.endpointURL(endpoint)
+}
+
+func (a *API) gameRosterEndpoint(year string, scheduleType ScheduleType, week, awayTeamId, homeTeamId string) (*url.URL, error) {
+	endpoint := fmt.Sprintf("%s/%s/%s/%s/%s/%s/roster.%s", a.baseEndpoint(), year, scheduleType, week, awayTeamId, homeTeamId, string(a.format))
+	return a.endpointURL(endpoint)
+}
+
+func (a *API) teamRosterEndpoint(teamId string) (*url.URL, error) {
+	endpoint := fmt.Sprintf("%s/teams/%s/roster.%s", a.baseEndpoint(), teamId, string(a.format))
+	return a.endpointURL(endpoint)
+}
+
+func (a *API) teamProfileEndpoint(teamId string) (*url.URL, error) {
+	endpoint := fmt.Sprintf("%s/teams/%s/profile.%s", a.baseEndpoint(), teamId, string(a.format))
+	return a.endpointURL(endpoint)
+}
+
+func (a *API) seasonalStatisticsEndpoint(year string, scheduleType ScheduleType, teamId string) (*url.URL, error) {
+	endpoint := fmt.Sprintf("%s/%s/%s/teams/%s/statistics.%s", a.baseEndpoint(), year, scheduleType, teamId, string(a.format))
+	return a.endpointURL(endpoint)
+}
+
+func (a *API) rankingsEndpoint(poll, year, week string) (*url.URL, error) {
+	endpoint := fmt.Sprintf("%s/polls/%s/%s/%s/rankings.%s", a.baseEndpoint(), poll, year, week, string(a.format))
+	return a.endpointURL(endpoint)
+}
+
+// endpointURL parses endpoint and attaches the api_key query parameter, the
+// way each of the *Endpoint builders above does.
+func (a *API) endpointURL(endpoint string) (*url.URL, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	q := u.Query()
+	q.Set("api_key", a.apiKey)
+	u.RawQuery = q.Encode()
+	return u, nil
+}
+
+func (a *API) PlayByPlay(ctx context.Context, year string, scheduleType ScheduleType, week, awayTeamId, homeTeamId string) (*PlayByPlay, error) {
+	u, err := a.playByPlayEndpoint(year, scheduleType, week, awayTeamId, homeTeamId)
+	if err != nil {
+		return nil, err
+	}
+	body, err := a.doRequest(ctx, u, CacheRevalidate)
+	if err != nil {
+		return nil, err
+	}
+	pbp := new(PlayByPlay)
+	err = a.decode(body, pbp)
+	return pbp, err
+}
+
+func (a *API) GameStatistics(ctx context.Context, year string, scheduleType ScheduleType, week, awayTeamId, homeTeamId string) (*GameStatistics, error) {
+	u, err := a.gameStatisticsEndpoint(year, scheduleType, week, awayTeamId, homeTeamId)
+	if err != nil {
+		return nil, err
+	}
+	body, err := a.doRequest(ctx, u, CacheRevalidate)
+	if err != nil {
+		return nil, err
+	}
+	stats := new(GameStatistics)
+	err = a.decode(body, stats)
+	return stats, err
+}
+
+func (a *API) GameRoster(ctx context.Context, year string, scheduleType ScheduleType, week, awayTeamId, homeTeamId string) (*GameRoster, error) {
+	u, err := a.gameRosterEndpoint(year, scheduleType, week, awayTeamId, homeTeamId)
+	if err != nil {
+		return nil, err
+	}
+	body, err := a.doRequest(ctx, u, CacheRevalidate)
+	if err != nil {
+		return nil, err
+	}
+	roster := new(GameRoster)
+	err = a.decode(body, roster)
+	return roster, err
+}
+
+func (a *API) TeamRoster(ctx context.Context, teamId string) (*TeamRoster, error) {
+	u, err := a.teamRosterEndpoint(teamId)
+	if err != nil {
+		return nil, err
+	}
+	body, err := a.doRequest(ctx, u, CacheRevalidate)
+	if err != nil {
+		return nil, err
+	}
+	roster := new(TeamRoster)
+	err = a.decode(body, roster)
+	return roster, err
+}
+
+func (a *API) TeamProfile(ctx context.Context, teamId string) (*TeamProfile, error) {
+	u, err := a.teamProfileEndpoint(teamId)
+	if err != nil {
+		return nil, err
+	}
+	body, err := a.doRequest(ctx, u, CacheRevalidate)
+	if err != nil {
+		return nil, err
+	}
+	profile := new(TeamProfile)
+	err = a.decode(body, profile)
+	return profile, err
+}
+
+func (a *API) SeasonalStatistics(ctx context.Context, year string, scheduleType ScheduleType, teamId string) (*SeasonalStatistics, error) {
+	u, err := a.seasonalStatisticsEndpoint(year, scheduleType, teamId)
+	if err != nil {
+		return nil, err
+	}
+	body, err := a.doRequest(ctx, u, CacheRevalidate)
+	if err != nil {
+		return nil, err
+	}
+	stats := new(SeasonalStatistics)
+	err = a.decode(body, stats)
+	return stats, err
+}
+
+func (a *API) RankingsAP(ctx context.Context, year, week string) (*Rankings, error) {
+	return a.rankings(ctx, "AP", year, week)
+}
+
+func (a *API) RankingsCoaches(ctx context.Context, year, week string) (*Rankings, error) {
+	return a.rankings(ctx, "Coaches", year, week)
+}
+
+func (a *API) rankings(ctx context.Context, poll, year, week string) (*Rankings, error) {
+	u, err := a.rankingsEndpoint(poll, year, week)
+	if err != nil {
+		return nil, err
+	}
+	body, err := a.doRequest(ctx, u, CacheRevalidate)
+	if err != nil {
+		return nil, err
+	}
+	rankings := new(Rankings)
+	if err := a.decode(body, rankings); err != nil {
+		return nil, err
+	}
+	rankings.Poll = poll
+	return rankings, nil
+}
+
+// PlayUpdate is a single new play surfaced by LiveFeed.
+type PlayUpdate struct {
+	Play Play
+}
+
+// LiveFeed polls PlayByPlay for the game identified by gameId within
+// schedule every interval, delivering each newly seen play over the
+// returned channel. The channel is closed when ctx is cancelled. If the
+// game can't be found in schedule, LiveFeed returns an error and no channel.
+func (a *API) LiveFeed(ctx context.Context, schedule *Schedule, gameId string, interval time.Duration) (<-chan PlayUpdate, error) {
+	refs := gamesByIDs(schedule, []string{gameId})
+	if len(refs) == 0 {
+		return nil, fmt.Errorf("ncaafb: game %s not found in schedule", gameId)
+	}
+	ref := refs[0]
+
+	updates := make(chan PlayUpdate)
+	go func() {
+		defer close(updates)
+		seen := make(map[string]bool)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			pbp, err := a.PlayByPlay(ctx, schedule.Year, schedule.ScheduleType, ref.week, ref.game.AwayTeamId, ref.game.HomeTeamId)
+			if err == nil {
+				for _, period := range pbp.Periods {
+					for _, play := range period.Plays {
+						if seen[play.Id] {
+							continue
+						}
+						seen[play.Id] = true
+						select {
+						case updates <- PlayUpdate{Play: play}:
+						case <-ctx.Done():
+							return
+						}
+					}
+				}
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return updates, nil
+}
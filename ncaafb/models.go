@@ -0,0 +1,140 @@
+package ncaafb
+
+import "encoding/xml"
+
+// Division is the root of a teams/{division}/hierarchy.xml document: a
+// division broken down into conferences and the teams that belong to them.
+type Division struct {
+	XMLName     xml.Name     `xml:"hierarchy" json:"-"`
+	Id          string       `xml:"id,attr" json:"id"`
+	Name        string       `xml:"name,attr" json:"name"`
+	Alias       string       `xml:"alias,attr" json:"alias"`
+	Conferences []Conference `xml:"conference" json:"conferences"`
+}
+
+type Conference struct {
+	Id    string `xml:"id,attr" json:"id"`
+	Name  string `xml:"name,attr" json:"name"`
+	Alias string `xml:"alias,attr" json:"alias"`
+	Teams []Team `xml:"team" json:"teams"`
+}
+
+type Team struct {
+	Id     string `xml:"id,attr" json:"id"`
+	Name   string `xml:"name,attr" json:"name"`
+	Market string `xml:"market,attr" json:"market"`
+	Alias  string `xml:"alias,attr" json:"alias"`
+}
+
+// Season is the document returned by {year}/{scheduleType}/schedule.xml.
+type Season struct {
+	XMLName xml.Name `xml:"schedule" json:"-"`
+	Weeks   []Week   `xml:"week" json:"weeks"`
+}
+
+// Schedule wraps a Season with the parameters used to fetch it, since those
+// aren't present in the upstream XML itself.
+type Schedule struct {
+	Year         string       `json:"year"`
+	ScheduleType ScheduleType `json:"schedule_type"`
+	Season       *Season      `json:"season"`
+}
+
+type Week struct {
+	Week  string `xml:"sequence,attr" json:"sequence"`
+	Games []Game `xml:"game" json:"games"`
+}
+
+type Game struct {
+	Id         string `xml:"id,attr" json:"id"`
+	AwayTeamId string `xml:"away_id,attr" json:"away_id"`
+	HomeTeamId string `xml:"home_id,attr" json:"home_id"`
+	Scheduled  string `xml:"scheduled,attr" json:"scheduled"`
+}
+
+// Boxscore is the document returned by .../boxscore.xml. Year, ScheduleType,
+// and Week are stamped on after unmarshaling since the endpoint doesn't echo
+// them back.
+type Boxscore struct {
+	XMLName      xml.Name     `xml:"boxscore" json:"-"`
+	Year         string       `xml:"-" json:"year"`
+	ScheduleType ScheduleType `xml:"-" json:"schedule_type"`
+	Week         string       `xml:"-" json:"week"`
+	GameId       string       `xml:"id,attr" json:"id"`
+	Status       string       `xml:"status,attr" json:"status"`
+}
+
+// PlayByPlay is the document returned by .../pbp.xml.
+type PlayByPlay struct {
+	XMLName xml.Name `xml:"pbp" json:"-"`
+	GameId  string   `xml:"id,attr" json:"id"`
+	Status  string   `xml:"status,attr" json:"status"`
+	Periods []Period `xml:"period" json:"periods"`
+}
+
+type Period struct {
+	Number int    `xml:"number,attr" json:"number"`
+	Plays  []Play `xml:"play" json:"plays"`
+}
+
+type Play struct {
+	Id          string `xml:"id,attr" json:"id"`
+	Sequence    int    `xml:"sequence,attr" json:"sequence"`
+	Clock       string `xml:"clock,attr" json:"clock"`
+	Description string `xml:"description,attr" json:"description"`
+}
+
+// GameStatistics is the document returned by .../statistics.xml.
+type GameStatistics struct {
+	XMLName xml.Name `xml:"statistics" json:"-"`
+	GameId  string   `xml:"id,attr" json:"id"`
+}
+
+// GameRoster is the document returned by .../roster.xml for a single game.
+type GameRoster struct {
+	XMLName xml.Name `xml:"roster" json:"-"`
+	GameId  string   `xml:"id,attr" json:"id"`
+}
+
+// TeamRoster is the document returned by teams/{id}/roster.xml.
+type TeamRoster struct {
+	XMLName xml.Name `xml:"roster" json:"-"`
+	TeamId  string   `xml:"id,attr" json:"id"`
+	Players []Player `xml:"player" json:"players"`
+}
+
+type Player struct {
+	Id       string `xml:"id,attr" json:"id"`
+	FullName string `xml:"full_name,attr" json:"full_name"`
+	Position string `xml:"position,attr" json:"position"`
+}
+
+// TeamProfile is the document returned by teams/{id}/profile.xml.
+type TeamProfile struct {
+	XMLName xml.Name `xml:"profile" json:"-"`
+	TeamId  string   `xml:"id,attr" json:"id"`
+	Name    string   `xml:"name,attr" json:"name"`
+	Venue   string   `xml:"venue,attr" json:"venue"`
+}
+
+// SeasonalStatistics is the document returned by
+// {year}/{scheduleType}/teams/{id}/statistics.xml.
+type SeasonalStatistics struct {
+	XMLName xml.Name `xml:"statistics" json:"-"`
+	TeamId  string   `xml:"id,attr" json:"id"`
+}
+
+// Rankings is the document returned by polls/{poll}/{year}/{week}/rankings.xml.
+// Poll is stamped on after unmarshaling since the endpoint doesn't echo it
+// back.
+type Rankings struct {
+	XMLName xml.Name     `xml:"polls" json:"-"`
+	Poll    string       `xml:"-" json:"poll"`
+	Teams   []RankedTeam `xml:"poll>team" json:"teams"`
+}
+
+type RankedTeam struct {
+	Rank   int    `xml:"rank,attr" json:"rank"`
+	TeamId string `xml:"id,attr" json:"team_id"`
+	Points int    `xml:"points,attr" json:"points"`
+}